@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/nsf/termbox-go"
 	"github.com/sachaos/todoist/lib"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
@@ -23,23 +29,156 @@ const (
 	Stop     AppState = "stop"
 )
 
+// Pane identifies which half of the UI currently receives keyboard input.
+type Pane string
+
+// List of possible Pane choices.
+const (
+	ProjectsPane Pane = "projects"
+	TasksPane    Pane = "tasks"
+)
+
 // TuiApp manages the state for the Todoist TUI interface.
 type TuiApp struct {
 	Tasks            []todoist.Item
 	PlaceholderTasks []string
 	Projects         []todoist.Project
 	CurrentProject   int
+	CurrentTask      int
+	Focus            Pane
 	Client           todoist.Client
 	ErrorHandler     func(err error)
 	ErrorMessage     string
 	State            AppState
 	events           chan termbox.Event
+
+	// pollQuit and pollDone let stopEventPump drain the termbox.PollEvent
+	// goroutine before a subprocess handoff (see enterAltScreen/
+	// leaveAltScreen) so keystrokes meant for $EDITOR don't leak back into
+	// t.events once we reinitialize termbox.
+	pollQuit chan struct{}
+	pollDone chan struct{}
+
+	// redraw and stop drive the invalidation-based main loop: redraw
+	// requests a Draw on the next iteration, stop tears the loop down
+	// immediately instead of waiting on the next tick.
+	redraw chan struct{}
+	stop   chan struct{}
+
+	// syncResult carries the outcome of a background Sync back to the main
+	// loop, and spinner animates drawLoadingOverlay while one is pending.
+	// clientMu guards every access to Client.Store: the sync goroutine
+	// writes it via Client.Sync, while the main loop reads it from
+	// currentProjectItems whenever the user navigates, so both sides have
+	// to take the lock rather than just the main loop.
+	syncResult  chan error
+	syncPending bool
+	spinner     *time.Ticker
+	spinnerTick int
+	clientMu    sync.Mutex
+
+	// InputMode, when non-empty, routes key events to readInput instead of
+	// the normal keybindings below (used by the "a" add, "/" filter and "e"
+	// due date prompts).
+	InputMode   string
+	InputBuffer string
+	Filter      string
+
+	// Log is threaded through every command dispatch and sync so failures
+	// show up with structured context instead of only in ErrorMessage.
+	// ShowLog toggles the "?" log viewer overlay, and LogLines is the ring
+	// buffer of recent formatted entries it tails. logMu guards LogLines,
+	// since tuiLogHook.Fire appends to it from whatever goroutine logs
+	// (including the background sync goroutine) while the main loop reads
+	// it in drawLogOverlay.
+	Log      *logrus.Entry
+	ShowLog  bool
+	LogLines []string
+	logMu    sync.Mutex
+}
+
+// maxLogLines bounds the in-memory ring buffer backing the log viewer
+// overlay so a long-running session doesn't grow it without bound.
+const maxLogLines = 200
+
+// logTailLines is how many of the most recent log lines the "?" overlay
+// shows at once.
+const logTailLines = 15
+
+// tuiLogHook mirrors every logged entry into TuiApp.LogLines so the "?"
+// overlay can tail it without re-reading the log file from disk.
+type tuiLogHook struct {
+	app *TuiApp
+}
+
+func (h *tuiLogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *tuiLogHook) Fire(entry *logrus.Entry) error {
+	line, err := entry.String()
+	if err != nil {
+		return err
+	}
+
+	h.app.logMu.Lock()
+	h.app.LogLines = append(h.app.LogLines, strings.TrimRight(line, "\n"))
+	if len(h.app.LogLines) > maxLogLines {
+		h.app.LogLines = h.app.LogLines[len(h.app.LogLines)-maxLogLines:]
+	}
+	h.app.logMu.Unlock()
+
+	return nil
+}
+
+// newLogger opens (creating if necessary) $XDG_STATE_HOME/todoist/tui.log
+// and returns a logrus entry that writes to it, mirroring entries into app
+// for the "?" log viewer overlay along the way.
+func newLogger(app *TuiApp) (*logrus.Entry, error) {
+	stateHome := os.Getenv("XDG_STATE_HOME")
+	if stateHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		stateHome = filepath.Join(home, ".local", "state")
+	}
+
+	logDir := filepath.Join(stateHome, "todoist")
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filepath.Join(logDir, "tui.log"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(f)
+	logger.SetLevel(logrus.DebugLevel)
+	logger.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	logger.AddHook(&tuiLogHook{app: app})
+
+	return logger.WithField("component", "tui"), nil
+}
+
+// Invalidate requests a redraw on the next iteration of the main loop. It is
+// safe to call from goroutines other than the one running Run, and never
+// blocks: a pending redraw that hasn't been drawn yet is enough, so a full
+// channel is treated as "already invalidated".
+func (t *TuiApp) Invalidate() {
+	select {
+	case t.redraw <- struct{}{}:
+	default:
+	}
 }
 
 func newTuiApp(client *todoist.Client) (*TuiApp, error) {
 	t := &TuiApp{}
 	t.Client = *client
 	t.State = Init
+	t.Focus = ProjectsPane
 	t.PlaceholderTasks = []string{
 		"item 1",
 		"item 2",
@@ -52,79 +191,265 @@ func newTuiApp(client *todoist.Client) (*TuiApp, error) {
 		"item 3",
 	}
 
+	log, err := newLogger(t)
+	if err != nil {
+		return nil, err
+	}
+	t.Log = log
+
 	return t, nil
 }
 
+// currentProjectItems returns the tasks belonging to the currently selected
+// project, in the order they appear in the synced store.
+func (t *TuiApp) currentProjectItems() []todoist.Item {
+	if len(t.Projects) == 0 {
+		return nil
+	}
+
+	projectID := t.Projects[t.CurrentProject].ID
+
+	t.clientMu.Lock()
+	defer t.clientMu.Unlock()
+
+	var items []todoist.Item
+	for _, item := range t.Client.Store.Items {
+		if item.ProjectID == projectID {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// selectProject resets the task pane to match the newly selected project.
+func (t *TuiApp) selectProject(index int) {
+	t.CurrentProject = index
+	t.Tasks = t.currentProjectItems()
+	t.CurrentTask = 0
+}
+
+// nextProject and prevProject cycle the project selection, guarding against
+// len(t.Projects) == 0 (e.g. during the initial load, or an account with no
+// projects) where the modulus below would otherwise panic.
+func (t *TuiApp) nextProject() {
+	if len(t.Projects) == 0 {
+		return
+	}
+	t.selectProject((t.CurrentProject + 1) % len(t.Projects))
+}
+
+func (t *TuiApp) prevProject() {
+	if len(t.Projects) == 0 {
+		return
+	}
+	t.selectProject((t.CurrentProject - 1 + len(t.Projects)) % len(t.Projects))
+}
+
+// visibleTasks returns t.Tasks narrowed down by the active filter, if any.
+func (t *TuiApp) visibleTasks() []todoist.Item {
+	if t.Filter == "" {
+		return t.Tasks
+	}
+
+	var filtered []todoist.Item
+	for _, item := range t.Tasks {
+		if strings.Contains(strings.ToLower(item.Content), strings.ToLower(t.Filter)) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+// syncAndReload re-syncs with the Todoist API. It runs in the background
+// (see startSync) and deliberately doesn't touch t.Projects/t.Tasks itself —
+// the main loop applies those once it receives the result on t.syncResult,
+// so the UI-facing fields only ever change on the goroutine that draws them.
+func (t *TuiApp) syncAndReload() error {
+	start := time.Now()
+
+	t.clientMu.Lock()
+	err := t.Client.Sync(context.Background())
+	t.clientMu.Unlock()
+
+	t.Log.WithFields(logrus.Fields{
+		"duration_ms": time.Since(start).Milliseconds(),
+		"err":         err,
+	}).Debug("sync")
+	return err
+}
+
+// startSync kicks off syncAndReload in the background and returns
+// immediately, leaving the caller free to keep handling input. The result is
+// delivered on t.syncResult, which the main loop selects on directly;
+// termbox.Interrupt() then also wakes PollEvent in the event pump, which
+// arrives as an EventInterrupt. Calling startSync while a sync is already
+// pending is a no-op.
+func (t *TuiApp) startSync() {
+	if t.syncPending {
+		return
+	}
+
+	t.syncPending = true
+	t.spinner = time.NewTicker(120 * time.Millisecond)
+
+	go func() {
+		err := t.syncAndReload()
+		t.syncResult <- err
+		termbox.Interrupt()
+	}()
+}
+
+// spinnerChan returns the ticker channel driving the loading spinner, or nil
+// when no sync is pending so the corresponding select case never fires.
+func (t *TuiApp) spinnerChan() <-chan time.Time {
+	if t.spinner == nil {
+		return nil
+	}
+	return t.spinner.C
+}
+
+// applySyncResult reacts to a value received on t.syncResult, updating
+// everything a completed background sync affects. It's shared by the main
+// loop and by editCurrentTask, which has to drain a pending sync itself
+// before it's safe to tear termbox down for the $EDITOR handoff.
+func (t *TuiApp) applySyncResult(err error) {
+	t.spinner.Stop()
+	t.spinner = nil
+	t.syncPending = false
+
+	if err != nil {
+		t.ErrorMessage = err.Error()
+	} else {
+		// Only the main loop touches t.Projects/t.Tasks, so apply the
+		// freshly-synced store here rather than from the background
+		// goroutine that ran the sync.
+		t.ErrorMessage = ""
+		t.clientMu.Lock()
+		t.Projects = t.Client.Store.Projects
+		t.clientMu.Unlock()
+		if t.State == LoadData {
+			t.selectProject(0)
+		} else {
+			t.Tasks = t.currentProjectItems()
+		}
+	}
+
+	if t.State == LoadData {
+		t.State = Ready
+	}
+}
+
 // Run orchestrates all of the moving pieces.
 func (t *TuiApp) Run() {
 
-	// Set up interrupt handler.
-	stop := make(chan os.Signal, 2)
+	t.redraw = make(chan struct{}, 1)
+	t.stop = make(chan struct{})
+	t.syncResult = make(chan error, 1)
+
+	// Set up interrupt handler. The first signal asks the main loop to wind
+	// down; a second signal while that's in flight exits directly.
+	sig := make(chan os.Signal, 2)
+	signal.Notify(sig, os.Interrupt)
 	go func() {
-		<-stop
-		t.State = Stop
-		<-stop
-		t.Stop() // second signal - exit directly.
+		<-sig
+		t.stopLoop()
+		<-sig
+		t.Stop()
+		os.Exit(1)
 	}()
 
 	// Set up termbox.
-	err := termbox.Init()
-	if err != nil {
+	if err := t.enterAltScreen(); err != nil {
 		panic(err)
 	}
-	termbox.SetInputMode(termbox.InputEsc)
 
-	// Load the initial data.
+	// Queue up termbox events for processing. This has to be running before
+	// we kick off the initial sync below, since a background sync unblocks
+	// PollEvent via termbox.Interrupt() rather than a real keystroke.
+	t.startEventPump()
+
+	// Kick off the initial sync in the background. The loop below stays
+	// responsive (Esc still quits) while drawLoadingOverlay animates.
 	t.State = LoadData
 	t.Draw()
-	t.Client.Sync(context.Background())
-	t.Projects = t.Client.Store.Projects
-	t.CurrentProject = 0
+	t.startSync()
+
+	// The watchdog fires periodically so a stale store eventually resyncs
+	// even if nothing else invalidates the UI. This is a backstop, not the
+	// primary refresh path (that's the "r" keybinding), so it's a long
+	// interval rather than something that hammers the API every session.
+	watchdog := time.NewTicker(5 * time.Minute)
+	defer watchdog.Stop()
+
+	// The main loop only redraws when something actually changed: an input
+	// event, a completed background sync, a resize, a spinner tick, or an
+	// explicit Invalidate() call. t.stop being closed (or the events channel
+	// being closed) ends the loop immediately instead of on the next tick.
+	for {
+		select {
+		case e, ok := <-t.events:
+			if !ok {
+				t.stopLoop()
+				continue
+			}
+			t.ProcessInput(e)
+			t.Invalidate()
 
-	// Draw the initial UI and set the state to Ready.
-	t.State = Ready
-	t.Draw()
+		case <-t.redraw:
+			t.Draw()
 
-	// Queue up termbox events for processing.
-	t.events = make(chan termbox.Event)
-	go func() {
-		for {
-			t.events <- termbox.PollEvent()
+		case <-watchdog.C:
+			t.startSync()
+
+		case <-t.spinnerChan():
+			t.spinnerTick++
+			t.Invalidate()
+
+		case err := <-t.syncResult:
+			t.applySyncResult(err)
+			t.Invalidate()
+
+		case <-t.stop:
+			t.State = Stop
 		}
-	}()
 
-	// The main loop should run at 60hz.
-	for range time.Tick(time.Duration(1000/60) * time.Millisecond) {
-		// If the last loop iteration put the app into a stop state, break out of the loop
 		if t.State == Stop {
 			break
 		}
-
-		t.ProcessInput()
-		t.Draw()
 	}
 
 	// Complete any shutdown tasks.
 	t.Stop()
 }
 
-// ProcessInput will process an event from the t.events channel.
-func (t *TuiApp) ProcessInput() {
-	var curEvent termbox.Event
-
+// stopLoop signals the main loop in Run to end. It is safe to call more than
+// once.
+func (t *TuiApp) stopLoop() {
 	select {
-	case e, ok := <-t.events:
-		curEvent = e
-		if !ok {
-			// Channel was closed and we need to stop the application.
-			t.State = Stop
-			return
-		}
+	case <-t.stop:
+	default:
+		close(t.stop)
 	}
+}
 
+// ProcessInput processes a single event pulled from the t.events channel by
+// Run's main loop.
+func (t *TuiApp) ProcessInput(curEvent termbox.Event) {
 	// If we've gotten to this point, we have an event that's ready to process.
 	switch curEvent.Type {
 	case termbox.EventKey:
+		t.Log.WithFields(logrus.Fields{
+			"key": curEvent.Key,
+			"ch":  curEvent.Ch,
+		}).Debug("key event")
+
+		if t.InputMode != "" {
+			t.readInput(curEvent)
+			return
+		}
+
 		switch curEvent.Key {
 
 		case termbox.KeyEsc:
@@ -133,38 +458,410 @@ func (t *TuiApp) ProcessInput() {
 		case termbox.KeyCtrlC:
 			t.State = Stop
 
-		case termbox.KeyPgdn:
-			t.CurrentProject++
-			if t.CurrentProject > len(t.Projects)-1 {
-				t.CurrentProject = 0
+		case termbox.KeyTab:
+			if t.Focus == ProjectsPane {
+				t.Focus = TasksPane
+			} else {
+				t.Focus = ProjectsPane
 			}
 
+		case termbox.KeyPgdn:
+			t.nextProject()
+
 		case termbox.KeyPgup:
-			t.CurrentProject--
-			if t.CurrentProject < 0 {
-				t.CurrentProject = len(t.Projects) - 1
-			}
+			t.prevProject()
 
+		default:
+			t.processRune(curEvent.Ch)
 		}
 
-	// If there's an error or an interrupt, stop the application.
+	// EventInterrupt is how a background sync (see startSync) wakes up
+	// PollEvent; it carries no error and isn't a reason to stop, so just
+	// let the loop come back around and redraw.
 	case termbox.EventInterrupt:
-		fallthrough
+
+	// A real termbox error means something went wrong reading the
+	// terminal, so stop the application.
 	case termbox.EventError:
-		t.ErrorMessage = curEvent.Err.Error()
+		if curEvent.Err != nil {
+			t.ErrorMessage = curEvent.Err.Error()
+		}
 		t.State = Stop
 	}
 }
 
+// processRune handles single-character keybindings that aren't covered by a
+// termbox.Key constant (vim-style navigation and task actions).
+func (t *TuiApp) processRune(ch rune) {
+	switch ch {
+
+	case 'j':
+		if t.Focus == ProjectsPane {
+			t.nextProject()
+		} else if tasks := t.visibleTasks(); len(tasks) > 0 {
+			t.CurrentTask = (t.CurrentTask + 1) % len(tasks)
+		}
+
+	case 'k':
+		if t.Focus == ProjectsPane {
+			t.prevProject()
+		} else if tasks := t.visibleTasks(); len(tasks) > 0 {
+			t.CurrentTask = (t.CurrentTask - 1 + len(tasks)) % len(tasks)
+		}
+
+	case 'x':
+		t.completeCurrentTask()
+
+	case 'd':
+		t.deleteCurrentTask()
+
+	case 'a':
+		t.InputMode = "add"
+		t.InputBuffer = ""
+
+	case '/':
+		t.InputMode = "filter"
+		t.InputBuffer = t.Filter
+
+	case 'e':
+		if task, ok := t.currentTask(); ok {
+			t.InputMode = "duedate"
+			t.InputBuffer = task.DateString
+		}
+
+	case 'r':
+		t.startSync()
+
+	case '?':
+		t.ShowLog = !t.ShowLog
+
+	case 'E':
+		t.editCurrentTask()
+	}
+}
+
+// currentTask returns the task pane's selection, if there is one.
+func (t *TuiApp) currentTask() (todoist.Item, bool) {
+	tasks := t.visibleTasks()
+	if t.CurrentTask < 0 || t.CurrentTask >= len(tasks) {
+		return todoist.Item{}, false
+	}
+
+	return tasks[t.CurrentTask], true
+}
+
+// completeCurrentTask marks the selected task done and reconciles with the
+// server in the background.
+func (t *TuiApp) completeCurrentTask() {
+	task, ok := t.currentTask()
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	err := t.Client.ItemComplete(context.Background(), task.ID)
+	t.Log.WithFields(logrus.Fields{
+		"task":        task.ID,
+		"project_id":  task.ProjectID,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"err":         err,
+	}).Debug("item complete")
+	if err != nil {
+		t.ErrorMessage = err.Error()
+		return
+	}
+
+	t.startSync()
+}
+
+// deleteCurrentTask removes the selected task and reconciles with the server
+// in the background.
+func (t *TuiApp) deleteCurrentTask() {
+	task, ok := t.currentTask()
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	err := t.Client.ItemDelete(context.Background(), task.ID)
+	t.Log.WithFields(logrus.Fields{
+		"task":        task.ID,
+		"project_id":  task.ProjectID,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"err":         err,
+	}).Debug("item delete")
+	if err != nil {
+		t.ErrorMessage = err.Error()
+		return
+	}
+
+	t.startSync()
+}
+
+// editCurrentTask suspends the TUI, opens $EDITOR on the selected task's
+// content and description, then applies whatever comes back via
+// ItemUpdate. It's the only place termbox gets torn down and reinitialized
+// mid-session, so it leans on enterAltScreen/leaveAltScreen and
+// stopEventPump/startEventPump rather than duplicating their logic.
+func (t *TuiApp) editCurrentTask() {
+	task, ok := t.currentTask()
+	if !ok {
+		return
+	}
+
+	// A sync kicked off just before "E" (the watchdog, "r", or a command
+	// dispatch) is still running on its own goroutine. Wait for its result
+	// and apply it before tearing termbox down. The goroutine calls
+	// termbox.Interrupt() only after sending that result, so the interrupt
+	// may still be pending here; whichever PollEvent runs next, before or
+	// after the handoff, takes it as an EventInterrupt.
+	if t.syncPending {
+		t.applySyncResult(<-t.syncResult)
+	}
+
+	t.stopEventPump()
+	t.leaveAltScreen()
+
+	content, description, err := runEditor(task.Content, task.Description)
+
+	if reErr := t.enterAltScreen(); reErr != nil {
+		panic(reErr)
+	}
+	t.startEventPump()
+
+	if err != nil {
+		t.ErrorMessage = err.Error()
+		t.Invalidate()
+		return
+	}
+
+	start := time.Now()
+	uerr := t.Client.ItemUpdate(context.Background(), task.ID, map[string]interface{}{
+		"content":     content,
+		"description": description,
+	})
+	t.Log.WithFields(logrus.Fields{
+		"task":        task.ID,
+		"project_id":  task.ProjectID,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"err":         uerr,
+	}).Debug("item edit")
+
+	if uerr != nil {
+		t.ErrorMessage = uerr.Error()
+	} else {
+		t.startSync()
+	}
+	t.Invalidate()
+}
+
+// runEditor writes content/description to a temp file in a simple key:value
+// format, opens it in $EDITOR, and parses whatever was saved back out. The
+// temp file is removed once the editor exits.
+func runEditor(content, description string) (string, string, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		return "", "", fmt.Errorf("$EDITOR is not set")
+	}
+
+	f, err := os.CreateTemp("", "todoist-item-*.txt")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.Remove(f.Name())
+
+	fmt.Fprintf(f, "content: %s\ndescription: %s\n", content, description)
+	if err := f.Close(); err != nil {
+		return "", "", err
+	}
+
+	cmd := exec.Command(editor, f.Name())
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", "", err
+	}
+
+	edited, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", "", err
+	}
+
+	return parseEditorFile(string(edited), content, description)
+}
+
+// parseEditorFile pulls the content/description fields back out of the
+// key:value format runEditor wrote, falling back to the original values for
+// any field the user didn't touch.
+func parseEditorFile(data, fallbackContent, fallbackDescription string) (string, string, error) {
+	content := fallbackContent
+	description := fallbackDescription
+
+	for _, line := range strings.Split(data, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch strings.TrimSpace(parts[0]) {
+		case "content":
+			content = strings.TrimSpace(parts[1])
+		case "description":
+			description = strings.TrimSpace(parts[1])
+		}
+	}
+
+	return content, description, nil
+}
+
+// readInput feeds keystrokes into t.InputBuffer while t.InputMode is set,
+// committing on Enter and cancelling on Esc. The "/" filter is applied as it
+// is typed, so Esc leaves the filter typed so far in place.
+func (t *TuiApp) readInput(e termbox.Event) {
+	switch e.Key {
+
+	case termbox.KeyEsc:
+		t.InputMode = ""
+		t.InputBuffer = ""
+
+	case termbox.KeyEnter:
+		t.commitInput()
+
+	case termbox.KeyBackspace, termbox.KeyBackspace2:
+		if len(t.InputBuffer) > 0 {
+			t.InputBuffer = t.InputBuffer[:len(t.InputBuffer)-1]
+		}
+
+	case termbox.KeySpace:
+		t.InputBuffer += " "
+
+	default:
+		if e.Ch != 0 {
+			t.InputBuffer += string(e.Ch)
+		}
+	}
+
+	if t.InputMode == "filter" {
+		t.Filter = t.InputBuffer
+		t.CurrentTask = 0
+	}
+}
+
+// commitInput applies the pending input buffer based on the active input
+// mode, then returns the app to normal keybinding handling.
+func (t *TuiApp) commitInput() {
+	switch t.InputMode {
+
+	case "filter":
+		t.Filter = t.InputBuffer
+
+	case "add":
+		if t.InputBuffer != "" && len(t.Projects) > 0 {
+			projectID := t.Projects[t.CurrentProject].ID
+			start := time.Now()
+			err := t.Client.ItemAdd(context.Background(), t.InputBuffer, projectID)
+			t.Log.WithFields(logrus.Fields{
+				"project_id":  projectID,
+				"duration_ms": time.Since(start).Milliseconds(),
+				"err":         err,
+			}).Debug("item add")
+			if err != nil {
+				t.ErrorMessage = err.Error()
+			} else {
+				t.startSync()
+			}
+		}
+
+	case "duedate":
+		if task, ok := t.currentTask(); ok {
+			start := time.Now()
+			err := t.Client.ItemUpdate(context.Background(), task.ID, map[string]interface{}{"date_string": t.InputBuffer})
+			t.Log.WithFields(logrus.Fields{
+				"task":        task.ID,
+				"project_id":  task.ProjectID,
+				"duration_ms": time.Since(start).Milliseconds(),
+				"err":         err,
+			}).Debug("item update")
+			if err != nil {
+				t.ErrorMessage = err.Error()
+			} else {
+				t.startSync()
+			}
+		}
+	}
+
+	t.InputMode = ""
+	t.InputBuffer = ""
+}
+
 // Stop handles all app shutdown tasks.
 func (t *TuiApp) Stop() {
-	termbox.Close()
+	t.leaveAltScreen()
 
 	if t.ErrorMessage != "" {
 		fmt.Println(t.ErrorMessage)
 	}
 }
 
+// enterAltScreen initializes termbox. It's split out from Run so the $EDITOR
+// handoff in editCurrentTask can tear down and reinitialize termbox around a
+// single subprocess instead of assuming it owns the terminal for the whole
+// process lifetime.
+func (t *TuiApp) enterAltScreen() error {
+	if err := termbox.Init(); err != nil {
+		return err
+	}
+	termbox.SetInputMode(termbox.InputEsc)
+	return nil
+}
+
+// leaveAltScreen tears termbox down, restoring the terminal to normal mode.
+func (t *TuiApp) leaveAltScreen() {
+	termbox.Close()
+}
+
+// startEventPump starts (or restarts, after a $EDITOR handoff) the goroutine
+// that feeds termbox.PollEvent into t.events.
+func (t *TuiApp) startEventPump() {
+	t.events = make(chan termbox.Event)
+	t.pollQuit = make(chan struct{})
+	t.pollDone = make(chan struct{})
+
+	quit, done, events := t.pollQuit, t.pollDone, t.events
+	go func() {
+		defer close(done)
+		for {
+			ev := termbox.PollEvent()
+
+			select {
+			case <-quit:
+				return
+			default:
+			}
+
+			select {
+			case events <- ev:
+			case <-quit:
+				return
+			}
+		}
+	}()
+}
+
+// stopEventPump signals the poll goroutine to stop, unblocks it with
+// termbox.Interrupt() since it may be parked in PollEvent, and waits for it
+// to exit so nothing is left reading termbox once we close it. Interrupt
+// blocks until a PollEvent takes it, and the poll goroutine may instead be
+// handing an event to t.events and return on pollQuit without polling again,
+// so the interrupt is sent from its own goroutine; a leftover one is taken by
+// the first PollEvent after startEventPump.
+func (t *TuiApp) stopEventPump() {
+	close(t.pollQuit)
+	go termbox.Interrupt()
+	<-t.pollDone
+}
+
 // Draw clears the screen, redraws everything, then flushes the result.
 func (t *TuiApp) Draw() {
 	w, h := termbox.Size()
@@ -201,12 +898,74 @@ func (t *TuiApp) Draw() {
 	for y := 0; y < h; y++ {
 		termbox.SetCell(longestProjectName+4, y, ' ', termbox.ColorDefault, termbox.ColorWhite)
 	}
+
+	// Draw the task pane header and contents to the right of the divider.
+	taskPaneX := longestProjectName + 6
+	for x := taskPaneX; x < w; x++ {
+		termbox.SetCell(x, 0, ' ', termbox.ColorDefault, termbox.ColorWhite)
+	}
+	t.drawString(taskPaneX, 0, "Tasks", true)
+
+	for key, task := range t.visibleTasks() {
+		if key == t.CurrentTask && t.Focus == TasksPane {
+			t.drawString(taskPaneX, 2+key, "> "+task.Content, false)
+		} else {
+			t.drawString(taskPaneX+2, 2+key, task.Content, false)
+		}
+	}
+
+	// A sync kicked off after the initial load (watchdog, "r", or a command
+	// dispatch) shows the spinner without blocking the rest of the UI.
+	if t.syncPending {
+		t.drawLoadingOverlay()
+	}
+
+	// Draw the pending input prompt, if any, on the bottom line.
+	if t.InputMode != "" {
+		t.drawString(0, h-1, t.InputMode+": "+t.InputBuffer, false)
+	} else if t.ErrorMessage != "" {
+		t.drawString(0, h-1, t.ErrorMessage, false)
+	}
+
+	// The "?" log viewer overlay is drawn last so it sits on top of
+	// everything else.
+	if t.ShowLog {
+		t.drawLogOverlay(w, h)
+	}
+}
+
+// drawLogOverlay tails the most recent log lines across the bottom of the
+// screen, for debugging sync failures without leaving the TUI.
+func (t *TuiApp) drawLogOverlay(w, h int) {
+	t.logMu.Lock()
+	lines := append([]string(nil), t.LogLines...)
+	t.logMu.Unlock()
+
+	if len(lines) > logTailLines {
+		lines = lines[len(lines)-logTailLines:]
+	}
+
+	top := h - len(lines) - 1
+	for y := top; y < h-1; y++ {
+		for x := 0; x < w; x++ {
+			termbox.SetCell(x, y, ' ', termbox.ColorDefault, termbox.ColorBlack)
+		}
+	}
+
+	for i, line := range lines {
+		t.drawString(0, top+i, line, false)
+	}
 }
 
+// spinnerFrames are cycled through by drawLoadingOverlay while a sync is
+// pending.
+var spinnerFrames = []rune{'|', '/', '-', '\\'}
+
 // Draw loading screen.
 func (t *TuiApp) drawLoadingOverlay() {
 	w, _ := termbox.Size()
-	t.drawString(w-10, 0, "Loading...", true)
+	frame := spinnerFrames[t.spinnerTick%len(spinnerFrames)]
+	t.drawString(w-12, 0, "Loading... "+string(frame), true)
 }
 
 // drawString puts text on the screen starting and the specified cell (x, y).